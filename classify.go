@@ -0,0 +1,82 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+)
+
+// IsTimeout checks whether err is a timeout, whether wrapped in *Error,
+// *Errors, or plain. It matches context.DeadlineExceeded and any error
+// implementing interface{ Timeout() bool } that returns true.
+func IsTimeout(err error) bool {
+	return IsFunc(func(err error) bool {
+		if err == nil {
+			return false
+		}
+		if err == context.DeadlineExceeded {
+			return true
+		}
+		t, ok := err.(interface{ Timeout() bool })
+		return ok && t.Timeout()
+	}, err)
+}
+
+// IsCanceled checks whether err is context.Canceled, whether wrapped in
+// *Error, *Errors, or plain.
+func IsCanceled(err error) bool {
+	return IsFunc(func(err error) bool {
+		return err == context.Canceled
+	}, err)
+}
+
+// IsTemporary checks whether err implements interface{ Temporary() bool }
+// and returns true, whether wrapped in *Error, *Errors, or plain.
+func IsTemporary(err error) bool {
+	return IsFunc(func(err error) bool {
+		if err == nil {
+			return false
+		}
+		t, ok := err.(interface{ Temporary() bool })
+		return ok && t.Temporary()
+	}, err)
+}
+
+var (
+	notFoundMu        sync.RWMutex
+	notFoundSentinels []error
+)
+
+// RegisterNotFound registers err as a sentinel that IsNotFound should also
+// recognize, on top of os.IsNotExist. This lets a driver register e.g.
+// sql.ErrNoRows or a gRPC NotFound status once, instead of every caller
+// unwrapping to inspect the root cause.
+func RegisterNotFound(err error) {
+	notFoundMu.Lock()
+	defer notFoundMu.Unlock()
+	notFoundSentinels = append(notFoundSentinels, err)
+}
+
+// IsNotFound checks whether err satisfies os.IsNotExist or matches any
+// sentinel registered via RegisterNotFound, whether wrapped in *Error,
+// *Errors, or plain. This is the intended check for idempotent retries,
+// e.g. treating a second DeleteVolume call's missing-state error as
+// success.
+func IsNotFound(err error) bool {
+	if IsNotExist(err) {
+		return true
+	}
+	notFoundMu.RLock()
+	sentinels := make([]error, len(notFoundSentinels))
+	copy(sentinels, notFoundSentinels)
+	notFoundMu.RUnlock()
+
+	return IsFunc(func(err error) bool {
+		for _, s := range sentinels {
+			if stderrors.Is(err, s) {
+				return true
+			}
+		}
+		return false
+	}, err)
+}
@@ -0,0 +1,74 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "temporary" }
+func (temporaryError) Temporary() bool { return true }
+
+func TestIsTimeout(t *testing.T) {
+	if !IsTimeout(context.DeadlineExceeded) {
+		t.Error("IsTimeout(context.DeadlineExceeded) should be true")
+	}
+	if !IsTimeout(NewError(context.DeadlineExceeded)) {
+		t.Error("IsTimeout for *Error should be true")
+	}
+	if !IsTimeout(New(timeoutError{})) {
+		t.Error("IsTimeout for *Errors should be true")
+	}
+	if IsTimeout(Errorf("dumb")) {
+		t.Error("IsTimeout should be false for an unrelated error")
+	}
+}
+
+func TestIsCanceled(t *testing.T) {
+	if !IsCanceled(context.Canceled) {
+		t.Error("IsCanceled(context.Canceled) should be true")
+	}
+	if !IsCanceled(NewError(context.Canceled)) {
+		t.Error("IsCanceled for *Error should be true")
+	}
+	if IsCanceled(Errorf("dumb")) {
+		t.Error("IsCanceled should be false for an unrelated error")
+	}
+}
+
+func TestIsTemporary(t *testing.T) {
+	if !IsTemporary(temporaryError{}) {
+		t.Error("IsTemporary(temporaryError{}) should be true")
+	}
+	if !IsTemporary(NewError(temporaryError{})) {
+		t.Error("IsTemporary for *Error should be true")
+	}
+	if IsTemporary(Errorf("dumb")) {
+		t.Error("IsTemporary should be false for an unrelated error")
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	errNoRows := errors.New("sql: no rows in result set")
+	RegisterNotFound(errNoRows)
+
+	if !IsNotFound(errNoRows) {
+		t.Error("IsNotFound(errNoRows) should be true")
+	}
+	if !IsNotFound(NewError(errNoRows)) {
+		t.Error("IsNotFound for *Error should be true")
+	}
+	if !IsNotFound(New(errNoRows)) {
+		t.Error("IsNotFound for *Errors should be true")
+	}
+	if IsNotFound(Errorf("dumb")) {
+		t.Error("IsNotFound should be false for an unregistered error")
+	}
+}
@@ -0,0 +1,117 @@
+package errors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestErrorsLenEachFilter(t *testing.T) {
+	errs := New(Errorf("one")).(*Errors)
+	errs = errs.Add(Errorf("two")).(*Errors)
+	errs = errs.Add(Errorf("three")).(*Errors)
+
+	if errs.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", errs.Len())
+	}
+
+	seen := 0
+	errs.Each(func(e *Error) bool {
+		seen++
+		return true
+	})
+	if seen != 3 {
+		t.Errorf("Each visited %d errors, want 3", seen)
+	}
+
+	stopped := 0
+	errs.Each(func(e *Error) bool {
+		stopped++
+		return false
+	})
+	if stopped != 1 {
+		t.Errorf("Each should stop after first false, visited %d", stopped)
+	}
+
+	filtered := errs.Filter(func(e *Error) bool {
+		return strings.Contains(e.Error(), "t")
+	})
+	if filtered.Len() != 2 {
+		t.Errorf("Filter() Len() = %d, want 2 (two, three)", filtered.Len())
+	}
+}
+
+func TestErrorsDedup(t *testing.T) {
+	bogusf := Newf("bogus %d")
+	errs := new(Errors)
+	errs.SetDedup(true)
+	errs.Add(bogusf(1))
+	errs.Add(bogusf(2))
+	errs.Add(bogusf(3))
+
+	if errs.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1 after dedup by id", errs.Len())
+	}
+	for _, count := range errs.Counts() {
+		if count != 3 {
+			t.Errorf("Counts() = %d, want 3", count)
+		}
+	}
+}
+
+func TestErrorsDedupByMessage(t *testing.T) {
+	errs := new(Errors)
+	errs.SetDedup(true)
+	for i := 0; i < 5; i++ {
+		errs.Add(Errorf("validation failed on field X"))
+	}
+
+	if errs.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1 after dedup by message (repeated Errorf calls are not Newf)", errs.Len())
+	}
+	for _, count := range errs.Counts() {
+		if count != 5 {
+			t.Errorf("Counts() = %d, want 5", count)
+		}
+	}
+}
+
+func TestErrorsDedupWithMaxErrors(t *testing.T) {
+	errs := new(Errors)
+	errs.SetDedup(true)
+	errs.MaxErrors = 1
+
+	errs.Add(Errorf("one"))
+	errs.Add(Errorf("two")) // new content, cap already reached: must be elided, not counted
+	errs.Add(Errorf("one")) // duplicate of a kept error: must still just bump its count
+
+	if errs.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", errs.Len())
+	}
+	if got := len(errs.Counts()); got != 1 {
+		t.Fatalf("Counts() has %d entries, want 1 (elided errors must not be counted)", got)
+	}
+	for _, count := range errs.Counts() {
+		if count != 2 {
+			t.Errorf("Counts() = %d, want 2 (two Adds of \"one\")", count)
+		}
+	}
+	if !strings.Contains(errs.Error(), "... and 1 more") {
+		t.Errorf("Error() = %q, should report the elided \"two\"", errs.Error())
+	}
+}
+
+func TestErrorsMaxErrors(t *testing.T) {
+	errs := new(Errors)
+	errs.MaxErrors = 2
+	errs.Add(Errorf("one"))
+	errs.Add(Errorf("two"))
+	errs.Add(Errorf("three"))
+	errs.Add(Errorf("four"))
+
+	if errs.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", errs.Len())
+	}
+	if !strings.Contains(errs.Error(), "... and 2 more") {
+		t.Errorf("Error() = %q, should contain elided count", errs.Error())
+	}
+}
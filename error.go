@@ -56,6 +56,7 @@ package errors
 
 import (
 	"bytes"
+	stderrors "errors"
 	"fmt"
 	"reflect"
 	"runtime"
@@ -77,11 +78,12 @@ func newid() int {
 // Error is an error with an attached stacktrace. It can be used
 // wherever the builtin error interface is expected.
 type Error struct {
-	Err    error
-	stack  []uintptr
-	frames []StackFrame
-	prefix string
-	id     int
+	Err      error
+	stack    []uintptr
+	frames   []StackFrame
+	prefix   string
+	id       int
+	fromNewf bool
 }
 
 // NewError makes an Error from the given value. If that value is already an
@@ -208,6 +210,19 @@ func (err *Error) Error() string {
 	return msg
 }
 
+// Unwrap returns the wrapped error, so *Error interoperates with the
+// standard errors.Is and errors.As.
+func (err *Error) Unwrap() error {
+	return err.Err
+}
+
+// As finds the first error in err's chain that matches target, in the same
+// sense as the standard errors.As. It is a convenience wrapper so callers
+// don't need to import errors just to unwrap an *Error.
+func (err *Error) As(target interface{}) bool {
+	return stderrors.As(err.Err, target)
+}
+
 // Stack returns the callstack formatted the same way that go does
 // in runtime/debug.Stack()
 func (err *Error) Stack() []byte {
@@ -252,9 +267,10 @@ func (err *Error) TypeName() string {
 // provide helpful error messages, while retaining the ability to be compared.
 func Newf(s string) Errf {
 	id := newid()
-	return func(args ...interface{}) *Error {
+	return func(args ...interface{}) error {
 		e := Errorf(s, args...)
 		e.id = id
+		e.fromNewf = true
 		return e
 	}
 }
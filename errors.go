@@ -1,14 +1,25 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
-	"github.com/golang/glog"
 	"strings"
 )
 
 // Errors is a list of errors with stack traces. It implements the error interface
 type Errors struct {
 	errs []*Error
+
+	// MaxErrors caps the number of errors Add will retain. Once reached,
+	// further additions are counted instead of appended, and Error and
+	// ErrorStack render a trailing "... and N more" line. Zero means
+	// unlimited.
+	MaxErrors int
+
+	dedup      bool
+	dedupIndex map[string]*Error
+	counts     map[*Error]int
+	elided     int
 }
 
 // Errf is a closure around Errorf to provide comparable but descriptive errors
@@ -29,29 +40,23 @@ func Add(e interface{}, ee interface{}) error {
 
 // Add returns a list of errors with the parameter added to the receiver,
 // it will behave correctly with a simple error, as well as with an errors.Error and an errors.Errors as parameters.
-// It will also log the error using glog if a verbosity of 3 or more is specified.
+// Each *Error that is kept or elided by MaxErrors is reported to the
+// package-level Logger (see SetLogger), which is a no-op unless configured;
+// a duplicate collapsed by SetDedup is only counted, not reported.
 func (e *Errors) Add(ee interface{}) error {
 	if ee != nil {
-		var err error
-
 		if e == nil {
 			e = &Errors{errs: make([]*Error, 0)}
 		}
 		switch ee := ee.(type) {
 		case *Error:
-			err = ee
-			e.errs = append(e.errs, ee)
+			e.addOne(ee)
 		case *Errors:
-			err = ee
-			for _, err := range err.(*Errors).errs {
-				e.errs = append(e.errs, err)
+			for _, err := range ee.errs {
+				e.addOne(err)
 			}
 		default:
-			err = NewError(ee)
-			e.errs = append(e.errs, err.(*Error))
-		}
-		if glog.V(3) {
-			glog.Errorln(err)
+			e.addOne(NewError(ee))
 		}
 	} else if e == nil {
 		return nil
@@ -59,6 +64,55 @@ func (e *Errors) Add(ee interface{}) error {
 	return e
 }
 
+// addOne appends err to e, honoring SetDedup and MaxErrors, and reports it
+// to the package-level Logger. A duplicate (per dedupKey) only ever bumps
+// its existing count, never the MaxErrors cap; a genuinely new error is
+// checked against the cap before it is committed to e.errs or to the dedup
+// bookkeeping, so Len() and Counts() never diverge from what was actually
+// kept.
+func (e *Errors) addOne(err *Error) {
+	if e.dedup {
+		key := dedupKey(err)
+		if existing, ok := e.dedupIndex[key]; ok {
+			e.counts[existing]++
+			return
+		}
+		if e.MaxErrors > 0 && len(e.errs) >= e.MaxErrors {
+			e.elided++
+			e.logElided(err)
+			return
+		}
+		if e.dedupIndex == nil {
+			e.dedupIndex = make(map[string]*Error)
+		}
+		e.dedupIndex[key] = err
+		e.counts[err] = 1
+		e.errs = append(e.errs, err)
+		e.logAdded(err)
+		return
+	}
+	if e.MaxErrors > 0 && len(e.errs) >= e.MaxErrors {
+		e.elided++
+		e.logElided(err)
+		return
+	}
+	e.errs = append(e.errs, err)
+	e.logAdded(err)
+}
+
+// dedupKey returns the key addOne uses to collapse duplicate adds: the
+// shared id assigned by Newf if err was made that way (so every call of
+// the same Newf closure collapses together), or its message otherwise.
+// err.id alone can't be used for this, since Wrap/NewError/Errorf also
+// assign every *Error a unique, ever-incrementing id that's never equal
+// between two distinct calls.
+func dedupKey(err *Error) string {
+	if err.fromNewf {
+		return fmt.Sprintf("id:%d", err.id)
+	}
+	return "msg:" + err.Error()
+}
+
 // Addf is a wrapper around Add to simply add a descriptive error to the list.
 func (e *Errors) Addf(fmts string, args ...interface{}) error {
 	return e.Add(fmt.Errorf(fmts, args...))
@@ -73,6 +127,9 @@ func (e *Errors) ErrorStack() string {
 	for i := range e.errs {
 		ret = append(ret, e.errs[i].ErrorStack())
 	}
+	if e.elided > 0 {
+		ret = append(ret, fmt.Sprintf("... and %d more", e.elided))
+	}
 	return strings.Join(ret, "\n")
 }
 
@@ -85,9 +142,65 @@ func (e *Errors) Error() string {
 	for i := range e.errs {
 		ret = append(ret, e.errs[i].Error())
 	}
+	if e.elided > 0 {
+		ret = append(ret, fmt.Sprintf("... and %d more", e.elided))
+	}
 	return strings.Join(ret, "\n")
 }
 
+// Len returns the number of errors contained in e.
+func (e *Errors) Len() int {
+	if e == nil {
+		return 0
+	}
+	return len(e.errs)
+}
+
+// Each calls fn for each contained error, in order, stopping early if fn
+// returns false.
+func (e *Errors) Each(fn func(*Error) bool) {
+	if e == nil {
+		return
+	}
+	for _, err := range e.errs {
+		if !fn(err) {
+			return
+		}
+	}
+}
+
+// Filter returns a new *Errors containing only the contained errors for
+// which fn returns true.
+func (e *Errors) Filter(fn func(*Error) bool) *Errors {
+	filtered := &Errors{errs: make([]*Error, 0)}
+	if e == nil {
+		return filtered
+	}
+	for _, err := range e.errs {
+		if fn(err) {
+			filtered.errs = append(filtered.errs, err)
+		}
+	}
+	return filtered
+}
+
+// SetDedup enables or disables deduplication of added errors. When enabled,
+// Add collapses errors that share the same Newf id or, lacking one, the
+// same Error() string, keeping a running count of each unique error instead
+// of appending duplicates. See Counts.
+func (e *Errors) SetDedup(dedup bool) {
+	e.dedup = dedup
+	if dedup && e.counts == nil {
+		e.counts = make(map[*Error]int)
+	}
+}
+
+// Counts returns the number of times each unique error has been added. It
+// is only populated while dedup is enabled via SetDedup.
+func (e *Errors) Counts() map[*Error]int {
+	return e.counts
+}
+
 // Is checks whether the parameter error is contained in the list of errors.
 // If the parameter is an errors.Errors, it will check whether at least one of their errors match.
 func (e *Errors) Is(ee error) bool {
@@ -112,6 +225,34 @@ func (e *Errors) Is(ee error) bool {
 	return false
 }
 
+// Unwrap returns the contained errors, implementing the multi-error
+// Unwrap() []error interface so *Errors interoperates with errors.Is,
+// errors.As and errors.Join.
+func (e *Errors) Unwrap() []error {
+	if e == nil {
+		return nil
+	}
+	errs := make([]error, len(e.errs))
+	for i, err := range e.errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+// As finds the first error among e's contained errors (or their own wrapped
+// chains) that matches target, in the same sense as the standard errors.As.
+func (e *Errors) As(target interface{}) bool {
+	if e == nil {
+		return false
+	}
+	for _, err := range e.errs {
+		if stderrors.As(error(err), target) {
+			return true
+		}
+	}
+	return false
+}
+
 // New returns a list of errors with the parameter added to the list.
 func New(err interface{}) error {
 	if err != nil {
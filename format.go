@@ -0,0 +1,183 @@
+package errors
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+)
+
+// Format implements fmt.Formatter. %+v prints the full stack trace, like
+// ErrorStack(); %v and %s print just the message chain, like Error(); %q
+// prints the quoted message.
+func (err *Error) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, err.ErrorStack())
+			return
+		}
+		io.WriteString(s, err.Error())
+	case 's':
+		io.WriteString(s, err.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", err.Error())
+	default:
+		fmt.Fprintf(s, "%%!%c(%T=%s)", verb, err, err.Error())
+	}
+}
+
+// Format implements fmt.Formatter. %+v prints the full stack trace of every
+// contained error, like ErrorStack(); %v and %s print just the message
+// chain, like Error(); %q prints the quoted message.
+func (e *Errors) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			io.WriteString(s, e.ErrorStack())
+			return
+		}
+		io.WriteString(s, e.Error())
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		fmt.Fprintf(s, "%%!%c(%T=%s)", verb, e, e.Error())
+	}
+}
+
+// jsonStackFrame is the JSON representation of a StackFrame.
+type jsonStackFrame struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+	Func string `json:"func"`
+}
+
+// jsonError is the JSON representation of an *Error.
+type jsonError struct {
+	Message string            `json:"message"`
+	Type    string            `json:"type,omitempty"`
+	ID      int               `json:"id,omitempty"`
+	Prefix  string            `json:"prefix,omitempty"`
+	Stack   []jsonStackFrame  `json:"stack,omitempty"`
+	Causes  []json.RawMessage `json:"causes,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, so services can ship errors to log
+// aggregators or return them from HTTP handlers without reflection.
+func (err *Error) MarshalJSON() ([]byte, error) {
+	je := jsonError{
+		Message: err.Err.Error(),
+		Type:    err.TypeName(),
+		ID:      err.id,
+		Prefix:  err.prefix,
+	}
+	for _, f := range err.StackFrames() {
+		je.Stack = append(je.Stack, jsonStackFrame{
+			File: f.File,
+			Line: f.LineNumber,
+			Func: f.Name,
+		})
+	}
+	switch err.Err.(type) {
+	case *Error, *Errors:
+		// err.Err is itself richer than a bare message: marshal it in full
+		// (id/stack/prefix/nested causes) rather than skipping past it to
+		// its own Unwrap(), which would silently drop that detail.
+		if raw, merr := marshalCause(err.Err); merr == nil {
+			je.Causes = append(je.Causes, raw)
+		}
+	default:
+		if u, ok := err.Err.(interface{ Unwrap() error }); ok {
+			if raw, merr := marshalCause(u.Unwrap()); merr == nil {
+				je.Causes = append(je.Causes, raw)
+			}
+		} else if u, ok := err.Err.(interface{ Unwrap() []error }); ok {
+			for _, cause := range u.Unwrap() {
+				if raw, merr := marshalCause(cause); merr == nil {
+					je.Causes = append(je.Causes, raw)
+				}
+			}
+		}
+	}
+	return json.Marshal(je)
+}
+
+// marshalCause marshals an arbitrary error for inclusion in a "causes"
+// array, using the richer encoding for *Error/*Errors and falling back to a
+// bare message for anything else.
+func marshalCause(err error) (json.RawMessage, error) {
+	if err == nil {
+		return nil, stderrors.New("errors: nil cause")
+	}
+	switch e := err.(type) {
+	case *Error:
+		return e.MarshalJSON()
+	case *Errors:
+		return e.MarshalJSON()
+	default:
+		return json.Marshal(struct {
+			Message string `json:"message"`
+		}{err.Error()})
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The result is a best-effort
+// reconstruction: stack frames become opaque StackFrame values without live
+// program counters, and causes are discarded since they can't be fed back
+// into Err's original type.
+func (err *Error) UnmarshalJSON(data []byte) error {
+	var je jsonError
+	if unmarshalErr := json.Unmarshal(data, &je); unmarshalErr != nil {
+		return unmarshalErr
+	}
+	err.Err = stderrors.New(je.Message)
+	err.prefix = je.Prefix
+	err.id = je.ID
+	err.stack = nil
+	err.frames = make([]StackFrame, 0, len(je.Stack))
+	for _, f := range je.Stack {
+		err.frames = append(err.frames, StackFrame{
+			File:       f.File,
+			LineNumber: f.Line,
+			Name:       f.Func,
+		})
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting a top-level array of the
+// contained errors' own JSON representation.
+func (e *Errors) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("null"), nil
+	}
+	arr := make([]json.RawMessage, 0, len(e.errs))
+	for _, err := range e.errs {
+		raw, merr := err.MarshalJSON()
+		if merr != nil {
+			return nil, merr
+		}
+		arr = append(arr, raw)
+	}
+	return json.Marshal(arr)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reconstructing each contained
+// error the same best-effort way as (*Error).UnmarshalJSON.
+func (e *Errors) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if unmarshalErr := json.Unmarshal(data, &raw); unmarshalErr != nil {
+		return unmarshalErr
+	}
+	e.errs = make([]*Error, 0, len(raw))
+	for _, r := range raw {
+		ne := &Error{}
+		if unmarshalErr := ne.UnmarshalJSON(r); unmarshalErr != nil {
+			return unmarshalErr
+		}
+		e.errs = append(e.errs, ne)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestErrorFormat(t *testing.T) {
+	err := Errorf("boom")
+
+	if got := fmt.Sprintf("%v", err); got != err.Error() {
+		t.Errorf("%%v = %q, want %q", got, err.Error())
+	}
+	if got := fmt.Sprintf("%q", err); got != fmt.Sprintf("%q", err.Error()) {
+		t.Errorf("%%q = %q, want %q", got, fmt.Sprintf("%q", err.Error()))
+	}
+	if got := fmt.Sprintf("%+v", err); !strings.Contains(got, err.Error()) {
+		t.Errorf("%%+v = %q, should contain %q", got, err.Error())
+	}
+	if got := fmt.Sprintf("%d", err); !strings.HasPrefix(got, "%!d(") {
+		t.Errorf("%%d = %q, want a bad-verb diagnostic", got)
+	}
+}
+
+func TestErrorsFormat(t *testing.T) {
+	errs := New(Errorf("one")).(*Errors)
+	errs = errs.Add(Errorf("two")).(*Errors)
+
+	if got := fmt.Sprintf("%v", errs); got != errs.Error() {
+		t.Errorf("%%v = %q, want %q", got, errs.Error())
+	}
+	if got := fmt.Sprintf("%+v", errs); !strings.Contains(got, "one") || !strings.Contains(got, "two") {
+		t.Errorf("%%+v = %q, should contain both messages", got)
+	}
+	if got := fmt.Sprintf("%d", errs); !strings.HasPrefix(got, "%!d(") {
+		t.Errorf("%%d = %q, want a bad-verb diagnostic", got)
+	}
+}
+
+func TestErrorJSONRoundtrip(t *testing.T) {
+	err := WrapPrefix(fmt.Errorf("root cause"), "while doing stuff", 0)
+
+	b, merr := json.Marshal(err)
+	if merr != nil {
+		t.Fatalf("Marshal: %v", merr)
+	}
+
+	var back Error
+	if uerr := json.Unmarshal(b, &back); uerr != nil {
+		t.Fatalf("Unmarshal: %v", uerr)
+	}
+	if back.Error() != err.Error() {
+		t.Errorf("roundtrip Error() = %q, want %q", back.Error(), err.Error())
+	}
+}
+
+// TestErrorJSONMarshalNestedError verifies that when err.Err is itself an
+// *Error (e.g. the result of wrapping an Errorf call), MarshalJSON preserves
+// its own id/stack/type in the causes array instead of skipping past it to
+// its Unwrap()'d grandchild.
+func TestErrorJSONMarshalNestedError(t *testing.T) {
+	inner := Errorf("inner boom")
+	outer := NewError(inner)
+
+	b, merr := json.Marshal(outer)
+	if merr != nil {
+		t.Fatalf("Marshal: %v", merr)
+	}
+
+	var je jsonError
+	if uerr := json.Unmarshal(b, &je); uerr != nil {
+		t.Fatalf("Unmarshal: %v", uerr)
+	}
+	if len(je.Causes) != 1 {
+		t.Fatalf("Causes has %d entries, want 1", len(je.Causes))
+	}
+
+	var cause jsonError
+	if uerr := json.Unmarshal(je.Causes[0], &cause); uerr != nil {
+		t.Fatalf("Unmarshal cause: %v", uerr)
+	}
+	if cause.ID != inner.id {
+		t.Errorf("cause.ID = %d, want %d", cause.ID, inner.id)
+	}
+	if cause.Type == "" {
+		t.Error("cause.Type should be preserved, not dropped to a bare message")
+	}
+	if len(cause.Stack) == 0 {
+		t.Error("cause.Stack should be preserved, not dropped to a bare message")
+	}
+}
+
+func TestErrorsJSONRoundtrip(t *testing.T) {
+	errs := New(Errorf("one")).(*Errors)
+	errs = errs.Add(Errorf("two")).(*Errors)
+
+	b, merr := json.Marshal(errs)
+	if merr != nil {
+		t.Fatalf("Marshal: %v", merr)
+	}
+
+	var back Errors
+	if uerr := json.Unmarshal(b, &back); uerr != nil {
+		t.Fatalf("Unmarshal: %v", uerr)
+	}
+	if back.Error() != errs.Error() {
+		t.Errorf("roundtrip Error() = %q, want %q", back.Error(), errs.Error())
+	}
+}
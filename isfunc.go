@@ -23,18 +23,37 @@ func IsPermission(err error) bool {
 // IsFunc try-casts err for *Error or *Errors,
 // and checks the underlying error(s) against provided fn.
 // If error is not of type *Error or *Errors, IsFunc simply calls fn(err)
+// and, if that fails, recurses through any Unwrap() error or
+// Unwrap() []error chain, so arbitrarily nested *Error/*Errors and
+// standard-library wrapped errors are still detected.
 func IsFunc(fn func(error) bool, err error) bool {
-	switch err.(type) {
+	if err == nil {
+		return false
+	}
+	switch e := err.(type) {
 	case *Error:
-		return fn(err.(*Error).Err)
+		return IsFunc(fn, e.Err)
 	case *Errors:
-		for _, errn := range err.(*Errors).errs {
-			if fn(errn.Err) {
+		for _, errn := range e.errs {
+			if IsFunc(fn, errn) {
 				return true
 			}
 		}
 		return false
 	default:
-		return fn(err)
+		if fn(err) {
+			return true
+		}
+		switch u := err.(type) {
+		case interface{ Unwrap() error }:
+			return IsFunc(fn, u.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, uerr := range u.Unwrap() {
+				if IsFunc(fn, uerr) {
+					return true
+				}
+			}
+		}
+		return false
 	}
 }
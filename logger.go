@@ -0,0 +1,46 @@
+package errors
+
+// Logger is the logging interface this package uses to report errors as
+// they are added to an *Errors. Implement it to wire this package into
+// whatever logger your application already uses; see the slogadapter,
+// logrusadapter and zapadapter subpackages for ready-made implementations.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// noopLogger discards everything. It is the default Logger so that using
+// this package never forces a logging dependency or configuration on callers
+// that don't call SetLogger.
+type noopLogger struct{}
+
+func (noopLogger) Debugf(format string, args ...interface{}) {}
+func (noopLogger) Errorf(format string, args ...interface{}) {}
+
+var pkgLogger Logger = noopLogger{}
+
+// SetLogger replaces the package-level logger used to report errors added
+// via (*Errors).Add. Passing nil restores the default no-op logger.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = noopLogger{}
+	}
+	pkgLogger = l
+}
+
+// logAdded reports err to the package-level logger's Debugf as one formatted
+// message carrying type, id, frame count, prefix and message as key=value
+// text; the Logger receives them only as format arguments, not as separate
+// structured fields.
+func (e *Errors) logAdded(err *Error) {
+	pkgLogger.Debugf("errors: added error type=%s id=%d frames=%d prefix=%q message=%q",
+		err.TypeName(), err.id, len(err.StackFrames()), err.prefix, err.Error())
+}
+
+// logElided reports err to the package-level logger's Errorf when it is
+// dropped because MaxErrors was reached: unlike a routine Add, losing an
+// error warrants a logger's error-level output rather than its debug one.
+func (e *Errors) logElided(err *Error) {
+	pkgLogger.Errorf("errors: elided error after MaxErrors=%d reached: type=%s id=%d message=%q",
+		e.MaxErrors, err.TypeName(), err.id, err.Error())
+}
@@ -0,0 +1,65 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	debugs []string
+	errs   []string
+}
+
+func (r *recordingLogger) Debugf(format string, args ...interface{}) {
+	r.debugs = append(r.debugs, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingLogger) Errorf(format string, args ...interface{}) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+func TestSetLoggerLogsAddedErrors(t *testing.T) {
+	rec := &recordingLogger{}
+	SetLogger(rec)
+	defer SetLogger(nil)
+
+	errs := new(Errors)
+	errs.Add(Errorf("boom"))
+
+	if len(rec.debugs) != 1 {
+		t.Fatalf("Debugf called %d times, want 1", len(rec.debugs))
+	}
+	if !strings.Contains(rec.debugs[0], "boom") {
+		t.Errorf("Debugf message %q should contain the error message", rec.debugs[0])
+	}
+	if len(rec.errs) != 0 {
+		t.Errorf("Errorf should not be called for a routine Add, got %v", rec.errs)
+	}
+}
+
+func TestSetLoggerLogsElidedErrors(t *testing.T) {
+	rec := &recordingLogger{}
+	SetLogger(rec)
+	defer SetLogger(nil)
+
+	errs := new(Errors)
+	errs.MaxErrors = 1
+	errs.Add(Errorf("one"))
+	errs.Add(Errorf("two"))
+
+	if len(rec.errs) != 1 {
+		t.Fatalf("Errorf called %d times, want 1", len(rec.errs))
+	}
+	if !strings.Contains(rec.errs[0], "two") {
+		t.Errorf("Errorf message %q should contain the elided error's message", rec.errs[0])
+	}
+}
+
+func TestSetLoggerNilRestoresNoop(t *testing.T) {
+	SetLogger(&recordingLogger{})
+	SetLogger(nil)
+
+	errs := new(Errors)
+	errs.Add(Errorf("boom")) // must not panic with the default no-op logger
+}
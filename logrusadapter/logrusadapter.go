@@ -0,0 +1,30 @@
+// Package logrusadapter adapts github.com/sirupsen/logrus to the
+// errors.Logger interface, so that errors from github.com/rkjdid/errors can
+// be reported through an application's existing logrus.Logger.
+package logrusadapter
+
+import "github.com/sirupsen/logrus"
+
+// Logger wraps a logrus.FieldLogger to implement errors.Logger.
+type Logger struct {
+	l logrus.FieldLogger
+}
+
+// New returns an errors.Logger backed by l. If l is nil, logrus.StandardLogger()
+// is used.
+func New(l logrus.FieldLogger) *Logger {
+	if l == nil {
+		l = logrus.StandardLogger()
+	}
+	return &Logger{l: l}
+}
+
+// Debugf implements errors.Logger.
+func (a *Logger) Debugf(format string, args ...interface{}) {
+	a.l.Debugf(format, args...)
+}
+
+// Errorf implements errors.Logger.
+func (a *Logger) Errorf(format string, args ...interface{}) {
+	a.l.Errorf(format, args...)
+}
@@ -0,0 +1,95 @@
+package errors
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// uncaughtPanic wraps a recovered panic value so it can be carried as a
+// regular error inside an *Error. TypeName reports it as "panic".
+type uncaughtPanic struct{ v interface{} }
+
+func (p uncaughtPanic) Error() string {
+	return fmt.Sprintf("%v", p.v)
+}
+
+// Recover turns the value returned by the builtin recover() into an *Error
+// with a stack trace pointing at the panic site, or nil if r is nil (i.e.
+// there was no panic). The builtin recover only has an effect when called
+// directly inside a deferred function literal, not through another function
+// call, so call it yourself there and pass its result through:
+//
+//  defer func() {
+//      if e := errors.Recover(recover()); e != nil {
+//          ...
+//      }
+//  }()
+func Recover(r interface{}) *Error {
+	if r == nil {
+		return nil
+	}
+	return newPanicError(r)
+}
+
+// RecoverInto recovers from a panic in the current goroutine and stores it
+// into *errp as an *Error. Unlike Recover, it can be deferred directly,
+// which is what lets its own call to the builtin recover() take effect:
+//
+//  func doStuff() (err error) {
+//      defer errors.RecoverInto(&err)
+//      ...
+//  }
+func RecoverInto(errp *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	*errp = newPanicError(r)
+}
+
+// newPanicError builds the *Error returned by Recover/RecoverInto, with a
+// stack trace starting at the panic site.
+func newPanicError(r interface{}) *Error {
+	stack := make([]uintptr, MaxStackDepth)
+	length := runtime.Callers(3, stack[:])
+	stack = stack[:length]
+	// Drop the deferred function and everything up to runtime.gopanic, then
+	// any runtime frames that raised the panic (e.g. for a nil dereference).
+	for i, pc := range stack {
+		if fn := runtime.FuncForPC(pc - 1); fn != nil && fn.Name() == "runtime.gopanic" {
+			stack = stack[i+1:]
+			for len(stack) > 0 {
+				if fn := runtime.FuncForPC(stack[0] - 1); fn == nil || !strings.HasPrefix(fn.Name(), "runtime.") {
+					break
+				}
+				stack = stack[1:]
+			}
+			break
+		}
+	}
+	return &Error{
+		Err:   uncaughtPanic{r},
+		stack: stack,
+		id:    newid(),
+	}
+}
+
+// SafeGo runs fn in a new goroutine, recovering any panic into an *Error,
+// and delivers either fn's returned error or the recovered panic on the
+// returned channel. This is the common pattern for supervisors and worker
+// pools that must not let a single goroutine's panic take down the process.
+func SafeGo(fn func() error) <-chan error {
+	ch := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() {
+			if e := Recover(recover()); e != nil {
+				err = e
+			}
+			ch <- err
+		}()
+		err = fn()
+	}()
+	return ch
+}
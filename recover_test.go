@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"testing"
+)
+
+func TestRecover(t *testing.T) {
+	e := func() (e *Error) {
+		defer func() {
+			e = Recover(recover())
+		}()
+		panic("oh no")
+	}()
+
+	if e == nil {
+		t.Fatal("Recover(recover()) should not be nil after a panic")
+	}
+	if e.TypeName() != "panic" {
+		t.Errorf("TypeName() = %q, want %q", e.TypeName(), "panic")
+	}
+	if e.Error() != "oh no" {
+		t.Errorf("Error() = %q, want %q", e.Error(), "oh no")
+	}
+}
+
+func TestRecoverNoPanic(t *testing.T) {
+	e := func() (e *Error) {
+		defer func() {
+			e = Recover(recover())
+		}()
+		return nil
+	}()
+
+	if e != nil {
+		t.Errorf("Recover(recover()) should be nil without a panic, got %#v", e)
+	}
+}
+
+func TestRecoverInto(t *testing.T) {
+	doStuff := func() (err error) {
+		defer RecoverInto(&err)
+		panic("boom")
+	}
+
+	err := doStuff()
+	if err == nil {
+		t.Fatal("RecoverInto should have set err")
+	}
+	if err.Error() != "boom" {
+		t.Errorf("Error() = %q, want %q", err.Error(), "boom")
+	}
+}
+
+func TestSafeGo(t *testing.T) {
+	err := <-SafeGo(func() error {
+		panic("goroutine boom")
+	})
+	if err == nil {
+		t.Fatal("SafeGo should deliver the recovered panic")
+	}
+	if err.Error() != "goroutine boom" {
+		t.Errorf("Error() = %q, want %q", err.Error(), "goroutine boom")
+	}
+
+	err = <-SafeGo(func() error {
+		return Errorf("plain error")
+	})
+	if err == nil || err.Error() != "plain error" {
+		t.Errorf("SafeGo should deliver fn's returned error, got %v", err)
+	}
+
+	err = <-SafeGo(func() error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("SafeGo should deliver nil when fn succeeds, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+// Package slogadapter adapts log/slog to the errors.Logger interface, so
+// that errors from github.com/rkjdid/errors can be reported through an
+// application's existing slog.Logger.
+package slogadapter
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Logger wraps a *slog.Logger to implement errors.Logger.
+type Logger struct {
+	l *slog.Logger
+}
+
+// New returns an errors.Logger backed by l. If l is nil, slog.Default() is
+// used.
+func New(l *slog.Logger) *Logger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &Logger{l: l}
+}
+
+// Debugf implements errors.Logger.
+func (a *Logger) Debugf(format string, args ...interface{}) {
+	a.l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Errorf implements errors.Logger.
+func (a *Logger) Errorf(format string, args ...interface{}) {
+	a.l.Error(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,71 @@
+package errors
+
+import (
+	stderrors "errors"
+	"os"
+	"testing"
+)
+
+func TestErrorUnwrap(t *testing.T) {
+	inner := stderrors.New("inner")
+	err := NewError(inner)
+	if err.Unwrap() != inner {
+		t.Errorf("Unwrap() = %v, want %v", err.Unwrap(), inner)
+	}
+}
+
+func TestErrorsUnwrap(t *testing.T) {
+	e1 := Errorf("one")
+	e2 := Errorf("two")
+	errs := New(e1).(*Errors)
+	errs = errs.Add(e2).(*Errors)
+
+	unwrapped := errs.Unwrap()
+	if len(unwrapped) != 2 {
+		t.Fatalf("Unwrap() returned %d errors, want 2", len(unwrapped))
+	}
+	if unwrapped[0] != error(e1) || unwrapped[1] != error(e2) {
+		t.Errorf("Unwrap() = %v, want [%v %v]", unwrapped, e1, e2)
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	_, pathErr := os.Open("/nonexistent/path/that/should/not/exist")
+	if pathErr == nil {
+		t.Fatal("expected os.Open to fail")
+	}
+	wrapped := NewError(pathErr)
+
+	var target *os.PathError
+	if !wrapped.As(&target) {
+		t.Fatal("(*Error).As should find the wrapped *os.PathError")
+	}
+	if target == nil {
+		t.Error("As target should be populated")
+	}
+}
+
+// TestStdlibIsAsThroughNestedChain verifies the scenario called out by the
+// request: a wrapped *os.PathError nested inside an *Error inside an
+// *Errors is still detected by the standard library's errors.Is/As, via
+// (*Error).Unwrap and (*Errors).Unwrap.
+func TestStdlibIsAsThroughNestedChain(t *testing.T) {
+	_, pathErr := os.Open("/nonexistent/path/that/should/not/exist")
+	if pathErr == nil {
+		t.Fatal("expected os.Open to fail")
+	}
+
+	nested := New(NewError(pathErr)).(*Errors)
+
+	if !stderrors.Is(nested, os.ErrNotExist) {
+		t.Error("stderrors.Is should find os.ErrNotExist through *Errors -> *Error -> *os.PathError")
+	}
+
+	var target *os.PathError
+	if !stderrors.As(nested, &target) {
+		t.Fatal("stderrors.As should find *os.PathError through *Errors -> *Error")
+	}
+	if target == nil {
+		t.Error("As target should be populated")
+	}
+}
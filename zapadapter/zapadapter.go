@@ -0,0 +1,30 @@
+// Package zapadapter adapts go.uber.org/zap to the errors.Logger interface,
+// so that errors from github.com/rkjdid/errors can be reported through an
+// application's existing zap.SugaredLogger.
+package zapadapter
+
+import "go.uber.org/zap"
+
+// Logger wraps a *zap.SugaredLogger to implement errors.Logger.
+type Logger struct {
+	l *zap.SugaredLogger
+}
+
+// New returns an errors.Logger backed by l. If l is nil, zap.NewNop().Sugar()
+// is used.
+func New(l *zap.SugaredLogger) *Logger {
+	if l == nil {
+		l = zap.NewNop().Sugar()
+	}
+	return &Logger{l: l}
+}
+
+// Debugf implements errors.Logger.
+func (a *Logger) Debugf(format string, args ...interface{}) {
+	a.l.Debugf(format, args...)
+}
+
+// Errorf implements errors.Logger.
+func (a *Logger) Errorf(format string, args ...interface{}) {
+	a.l.Errorf(format, args...)
+}